@@ -0,0 +1,31 @@
+// Package cache defines a small pluggable cache interface for upstream
+// provider responses. Memory is the only implementation today; a Redis
+// (or memcached) implementation can be dropped in behind the same
+// interface without touching callers.
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// Cache stores byte-slice values under string keys with a per-entry TTL.
+type Cache interface {
+	Get(ctx context.Context, key string) (value []byte, ok bool)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration)
+}
+
+type bypassKey struct{}
+
+// WithBypass marks a context so callers reading it (e.g. providers.NWS)
+// skip cache reads, satisfying a request's ?nocache=1. providers.NWS still
+// stores the fresh upstream response.
+func WithBypass(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassKey{}, true)
+}
+
+// Bypassed reports whether WithBypass was set on ctx.
+func Bypassed(ctx context.Context) bool {
+	v, _ := ctx.Value(bypassKey{}).(bool)
+	return v
+}
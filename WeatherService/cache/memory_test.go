@@ -0,0 +1,42 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemoryGetSet(t *testing.T) {
+	m := NewMemory()
+	ctx := context.Background()
+
+	if _, ok := m.Get(ctx, "missing"); ok {
+		t.Fatal("expected miss on empty cache")
+	}
+
+	m.Set(ctx, "key", []byte("value"), time.Minute)
+	value, ok := m.Get(ctx, "key")
+	if !ok || string(value) != "value" {
+		t.Fatalf("expected hit with %q, got %q ok=%v", "value", value, ok)
+	}
+}
+
+func TestMemoryExpiry(t *testing.T) {
+	m := NewMemory()
+	ctx := context.Background()
+
+	m.Set(ctx, "key", []byte("value"), -time.Second) // already expired
+	if _, ok := m.Get(ctx, "key"); ok {
+		t.Fatal("expected miss on expired entry")
+	}
+}
+
+func TestBypass(t *testing.T) {
+	ctx := context.Background()
+	if Bypassed(ctx) {
+		t.Fatal("expected plain context to not be bypassed")
+	}
+	if !Bypassed(WithBypass(ctx)) {
+		t.Fatal("expected WithBypass context to be bypassed")
+	}
+}
@@ -0,0 +1,13 @@
+// Package geocoder resolves human-readable locations (ZIP codes, city
+// names) to coordinates so transports that don't take lat/lon directly,
+// like the gRPC OneOfLocation request, can still reach the forecast
+// pipeline.
+package geocoder
+
+import "context"
+
+// Geocoder resolves a free-form query (ZIP code or city name) to a
+// latitude/longitude pair.
+type Geocoder interface {
+	Geocode(ctx context.Context, query string) (lat, lon float64, err error)
+}
@@ -0,0 +1,74 @@
+package geocoder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// NominatimBaseURL is the default OSM Nominatim search endpoint.
+const NominatimBaseURL = "https://nominatim.openstreetmap.org/search"
+
+// nominatimResult mirrors the handful of fields we need from a Nominatim
+// search response; the API returns many more that we don't use.
+type nominatimResult struct {
+	Lat string `json:"lat"`
+	Lon string `json:"lon"`
+}
+
+// Nominatim geocodes against the public OSM Nominatim API.
+type Nominatim struct {
+	BaseURL   string
+	UserAgent string // Nominatim's usage policy requires an identifying User-Agent
+	Client    *http.Client
+}
+
+// NewNominatim builds a Nominatim geocoder. userAgent is required by
+// Nominatim's usage policy and should identify the calling application.
+func NewNominatim(userAgent string) *Nominatim {
+	return &Nominatim{
+		BaseURL:   NominatimBaseURL,
+		UserAgent: userAgent,
+		Client:    http.DefaultClient,
+	}
+}
+
+func (n *Nominatim) Geocode(ctx context.Context, query string) (float64, float64, error) {
+	params := url.Values{"q": {query}, "format": {"json"}, "limit": {"1"}}
+	reqURL := n.BaseURL + "?" + params.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	req.Header.Set("User-Agent", n.UserAgent)
+
+	resp, err := n.Client.Do(req)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, 0, fmt.Errorf("nominatim: unexpected status %d", resp.StatusCode)
+	}
+
+	var results []nominatimResult
+	if err := json.NewDecoder(resp.Body).Decode(&results); err != nil {
+		return 0, 0, fmt.Errorf("nominatim: decoding response: %w", err)
+	}
+	if len(results) == 0 {
+		return 0, 0, fmt.Errorf("nominatim: no results for %q", query)
+	}
+
+	lat, err := strconv.ParseFloat(results[0].Lat, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("nominatim: parsing lat: %w", err)
+	}
+	lon, err := strconv.ParseFloat(results[0].Lon, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("nominatim: parsing lon: %w", err)
+	}
+	return lat, lon, nil
+}
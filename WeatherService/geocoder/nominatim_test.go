@@ -0,0 +1,43 @@
+package geocoder
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const nominatimFixture = `[{"lat": "40.7128", "lon": "-74.0060"}]`
+
+func TestNominatimGeocodeEscapesMultiWordQuery(t *testing.T) {
+	var gotQuery string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.Query().Get("q")
+		w.Write([]byte(nominatimFixture))
+	}))
+	defer server.Close()
+
+	n := &Nominatim{BaseURL: server.URL, UserAgent: "WeatherService/1.0 test@example.com", Client: server.Client()}
+	lat, lon, err := n.Geocode(context.Background(), "New York City")
+	if err != nil {
+		t.Fatalf("Geocode returned error: %v", err)
+	}
+	if gotQuery != "New York City" {
+		t.Fatalf("expected upstream to receive the unescaped query, got %q", gotQuery)
+	}
+	if lat != 40.7128 || lon != -74.0060 {
+		t.Fatalf("unexpected coordinates: %v, %v", lat, lon)
+	}
+}
+
+func TestNominatimGeocodeNoResults(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	n := &Nominatim{BaseURL: server.URL, UserAgent: "WeatherService/1.0 test@example.com", Client: server.Client()}
+	if _, _, err := n.Geocode(context.Background(), "Nowhereville"); err == nil {
+		t.Fatal("expected an error for no results")
+	}
+}
@@ -0,0 +1,28 @@
+// Package metrics holds simple in-process counters. It doesn't export
+// anywhere (no /metrics endpoint yet) — it just gives providers
+// somewhere to record cache hits/misses that a caller can read back for
+// diagnostics or, later, plumb into Prometheus.
+package metrics
+
+import "sync/atomic"
+
+// CacheCounters tracks hits and misses for a single cache.
+type CacheCounters struct {
+	hits   int64
+	misses int64
+}
+
+// Hit records a cache hit.
+func (c *CacheCounters) Hit() {
+	atomic.AddInt64(&c.hits, 1)
+}
+
+// Miss records a cache miss.
+func (c *CacheCounters) Miss() {
+	atomic.AddInt64(&c.misses, 1)
+}
+
+// Snapshot returns the current hit/miss totals.
+func (c *CacheCounters) Snapshot() (hits, misses int64) {
+	return atomic.LoadInt64(&c.hits), atomic.LoadInt64(&c.misses)
+}
@@ -0,0 +1,82 @@
+package providers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Edmond-Underwood/WeatherService/cache"
+	"github.com/Edmond-Underwood/WeatherService/metrics"
+)
+
+func TestNWSFetchUsesCache(t *testing.T) {
+	var pointsHits, forecastHits int
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		pointsHits++
+		w.Write([]byte(fmt.Sprintf(nwsPointsFixture, server.URL)))
+	})
+	mux.HandleFunc("/forecast", func(w http.ResponseWriter, r *http.Request) {
+		forecastHits++
+		w.Write([]byte(nwsForecastFixture))
+	})
+
+	p := &NWS{
+		BaseURL: server.URL,
+		Client:  server.Client(),
+		Cache:   cache.NewMemory(),
+		Metrics: &metrics.CacheCounters{},
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := p.Fetch(context.Background(), 39.75, -97.09, "imperial"); err != nil {
+			t.Fatalf("Fetch #%d returned error: %v", i, err)
+		}
+	}
+
+	if pointsHits != 1 || forecastHits != 1 {
+		t.Fatalf("expected 1 upstream call each after caching, got points=%d forecast=%d", pointsHits, forecastHits)
+	}
+
+	hits, misses := p.Metrics.Snapshot()
+	if hits != 4 || misses != 2 {
+		// 3 calls x 2 lookups (points, forecast) = 6 total; the first
+		// call misses both caches, the next two hit both.
+		t.Fatalf("expected 4 hits and 2 misses, got hits=%d misses=%d", hits, misses)
+	}
+}
+
+func TestNWSFetchNoCacheBypass(t *testing.T) {
+	var pointsHits int
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		pointsHits++
+		w.Write([]byte(fmt.Sprintf(nwsPointsFixture, server.URL)))
+	})
+	mux.HandleFunc("/forecast", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(nwsForecastFixture))
+	})
+
+	p := &NWS{BaseURL: server.URL, Client: server.Client(), Cache: cache.NewMemory()}
+
+	ctx := cache.WithBypass(context.Background())
+	for i := 0; i < 2; i++ {
+		if _, err := p.Fetch(ctx, 39.75, -97.09, "imperial"); err != nil {
+			t.Fatalf("Fetch #%d returned error: %v", i, err)
+		}
+	}
+
+	if pointsHits != 2 {
+		t.Fatalf("expected every call to bypass the cache, got %d upstream hits", pointsHits)
+	}
+}
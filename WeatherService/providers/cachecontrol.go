@@ -0,0 +1,36 @@
+package providers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ttlFromResponse honors the upstream Cache-Control max-age or Expires
+// header if present, falling back to def otherwise.
+func ttlFromResponse(resp *http.Response, def time.Duration) time.Duration {
+	if cc := resp.Header.Get("Cache-Control"); cc != "" {
+		for _, directive := range strings.Split(cc, ",") {
+			directive = strings.TrimSpace(directive)
+			if !strings.HasPrefix(directive, "max-age=") {
+				continue
+			}
+			seconds, err := strconv.Atoi(strings.TrimPrefix(directive, "max-age="))
+			if err == nil && seconds >= 0 {
+				return time.Duration(seconds) * time.Second
+			}
+		}
+	}
+
+	if exp := resp.Header.Get("Expires"); exp != "" {
+		if t, err := http.ParseTime(exp); err == nil {
+			if ttl := time.Until(t); ttl > 0 {
+				return ttl
+			}
+			return 0
+		}
+	}
+
+	return def
+}
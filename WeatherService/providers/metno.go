@@ -0,0 +1,160 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// MetNoBaseURL is MET Norway's Locationforecast compact endpoint.
+// Temperatures are always returned in Celsius.
+const MetNoBaseURL = "https://api.met.no/weatherapi/locationforecast/2.0/compact"
+
+// metNoTimeseriesEntry is one entry of a Locationforecast timeseries:
+// an instant reading plus a rolling next-hour summary.
+type metNoTimeseriesEntry struct {
+	Time time.Time `json:"time"`
+	Data struct {
+		Instant struct {
+			Details struct {
+				AirTemperature float64 `json:"air_temperature"`
+				WindSpeed      float64 `json:"wind_speed"`
+			} `json:"details"`
+		} `json:"instant"`
+		Next1Hours struct {
+			Summary struct {
+				SymbolCode string `json:"symbol_code"`
+			} `json:"summary"`
+		} `json:"next_1_hours"`
+	} `json:"data"`
+}
+
+// metNoResponse holds the subset of the Locationforecast response we care
+// about: the timeseries of instant temperature and next-hour summaries.
+type metNoResponse struct {
+	Properties struct {
+		Timeseries []metNoTimeseriesEntry `json:"timeseries"`
+	} `json:"properties"`
+}
+
+// MetNo is a Provider backed by MET Norway's Locationforecast API. MET
+// Norway's terms of service require an identifying User-Agent on every
+// request.
+type MetNo struct {
+	BaseURL   string // overridable in tests; defaults to MetNoBaseURL
+	UserAgent string
+	Client    *http.Client
+	Timeout   time.Duration // default DefaultTimeout
+}
+
+// NewMetNo builds a MetNo provider. userAgent should identify the calling
+// application and a contact, per MET Norway's usage terms.
+func NewMetNo(userAgent string) *MetNo {
+	return &MetNo{BaseURL: MetNoBaseURL, UserAgent: userAgent}
+}
+
+func (p *MetNo) client() *http.Client {
+	if p.Client != nil {
+		return p.Client
+	}
+	return &http.Client{Timeout: p.timeout()}
+}
+
+func (p *MetNo) timeout() time.Duration {
+	if p.Timeout > 0 {
+		return p.Timeout
+	}
+	return DefaultTimeout
+}
+
+func (p *MetNo) fetchTimeseries(ctx context.Context, lat, lon float64) ([]metNoTimeseriesEntry, error) {
+	baseURL := p.BaseURL
+	if baseURL == "" {
+		baseURL = MetNoBaseURL
+	}
+	url := fmt.Sprintf("%s?lat=%f&lon=%f", baseURL, lat, lon)
+	ctx, cancel := context.WithTimeout(ctx, p.timeout())
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("metno: building request: %w", err)
+	}
+	req.Header.Set("User-Agent", p.UserAgent)
+
+	resp, err := doWithRetry(ctx, p.client(), req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: met norway returned %d", ErrUnavailable, resp.StatusCode)
+	}
+
+	var metResp metNoResponse
+	if err := json.NewDecoder(resp.Body).Decode(&metResp); err != nil {
+		return nil, fmt.Errorf("metno: decoding response: %w", err)
+	}
+	if len(metResp.Properties.Timeseries) == 0 {
+		return nil, ErrNotFound
+	}
+	return metResp.Properties.Timeseries, nil
+}
+
+func (p *MetNo) Fetch(ctx context.Context, lat, lon float64, units string) (Forecast, error) {
+	timeseries, err := p.fetchTimeseries(ctx, lat, lon)
+	if err != nil {
+		return Forecast{}, err
+	}
+
+	toUnit := unitOf(units)
+	now := timeseries[0].Data
+	return Forecast{
+		TempValue: convertTemp(now.Instant.Details.AirTemperature, "C", toUnit),
+		TempUnit:  toUnit,
+		Text:      now.Next1Hours.Summary.SymbolCode,
+	}, nil
+}
+
+// FetchPeriods takes one timeseries entry per calendar day (MET Norway's
+// compact endpoint doesn't group into named day/night periods the way
+// NWS does) to approximate the "daily period" shape the rest of the
+// service expects.
+func (p *MetNo) FetchPeriods(ctx context.Context, lat, lon float64, units string, days int) ([]Period, error) {
+	timeseries, err := p.fetchTimeseries(ctx, lat, lon)
+	if err != nil {
+		return nil, err
+	}
+
+	toUnit := unitOf(units)
+	var out []Period
+	lastDay := -1
+	for _, entry := range timeseries {
+		if entry.Time.Day() == lastDay {
+			continue // keep the first entry seen for each day
+		}
+		lastDay = entry.Time.Day()
+
+		details := entry.Data.Instant.Details
+		symbol := entry.Data.Next1Hours.Summary.SymbolCode
+		out = append(out, Period{
+			Name:             entry.Time.Format("Monday"),
+			StartTime:        entry.Time,
+			EndTime:          entry.Time.Add(24 * time.Hour),
+			TempValue:        convertTemp(details.AirTemperature, "C", toUnit),
+			TempUnit:         toUnit,
+			Wind:             fmt.Sprintf("%g m/s", details.WindSpeed),
+			ShortForecast:    symbol,
+			DetailedForecast: symbol,
+		})
+		if days > 0 && len(out) >= days {
+			break
+		}
+	}
+	return out, nil
+}
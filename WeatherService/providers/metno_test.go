@@ -0,0 +1,116 @@
+package providers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// Fixture recorded from api.met.no/weatherapi/locationforecast/2.0/compact,
+// trimmed to the fields this provider reads.
+const metNoFixture = `{
+	"properties": {
+		"timeseries": [
+			{
+				"data": {
+					"instant": {
+						"details": {"air_temperature": 14.2}
+					},
+					"next_1_hours": {
+						"summary": {"symbol_code": "partlycloudy_day"}
+					}
+				}
+			}
+		]
+	}
+}`
+
+func TestMetNoFetchMetric(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("User-Agent") == "" {
+			t.Error("expected User-Agent header on request")
+		}
+		w.Write([]byte(metNoFixture))
+	}))
+	defer server.Close()
+
+	p := &MetNo{BaseURL: server.URL, UserAgent: "WeatherService/1.0 test@example.com", Client: server.Client()}
+	fc, err := p.Fetch(context.Background(), 59.9, 10.7, "metric")
+	if err != nil {
+		t.Fatalf("Fetch returned error: %v", err)
+	}
+	if fc.TempValue != 14.2 || fc.TempUnit != "C" {
+		t.Fatalf("unexpected forecast: %+v", fc)
+	}
+	if fc.Text != "partlycloudy_day" {
+		t.Fatalf("unexpected forecast text: %q", fc.Text)
+	}
+}
+
+const metNoMultiDayFixture = `{
+	"properties": {
+		"timeseries": [
+			{"time": "2026-01-01T12:00:00Z", "data": {"instant": {"details": {"air_temperature": 10, "wind_speed": 3}}, "next_1_hours": {"summary": {"symbol_code": "clearsky_day"}}}},
+			{"time": "2026-01-01T13:00:00Z", "data": {"instant": {"details": {"air_temperature": 11, "wind_speed": 3}}, "next_1_hours": {"summary": {"symbol_code": "clearsky_day"}}}},
+			{"time": "2026-01-02T12:00:00Z", "data": {"instant": {"details": {"air_temperature": 9, "wind_speed": 4}}, "next_1_hours": {"summary": {"symbol_code": "cloudy"}}}}
+		]
+	}
+}`
+
+func TestMetNoFetchPeriods(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(metNoMultiDayFixture))
+	}))
+	defer server.Close()
+
+	p := &MetNo{BaseURL: server.URL, UserAgent: "WeatherService/1.0 test@example.com", Client: server.Client()}
+	periods, err := p.FetchPeriods(context.Background(), 59.9, 10.7, "metric", 2)
+	if err != nil {
+		t.Fatalf("FetchPeriods returned error: %v", err)
+	}
+	if len(periods) != 2 {
+		t.Fatalf("expected 2 periods (one per day), got %d", len(periods))
+	}
+	if periods[0].TempValue != 10 {
+		t.Fatalf("expected first entry of the day to be kept, got %+v", periods[0])
+	}
+}
+
+func TestMetNoFetchImperialConversion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(metNoFixture))
+	}))
+	defer server.Close()
+
+	p := &MetNo{BaseURL: server.URL, UserAgent: "WeatherService/1.0 test@example.com", Client: server.Client()}
+	fc, err := p.Fetch(context.Background(), 59.9, 10.7, "imperial")
+	if err != nil {
+		t.Fatalf("Fetch returned error: %v", err)
+	}
+	if fc.TempUnit != "F" {
+		t.Fatalf("expected Fahrenheit conversion, got unit %q", fc.TempUnit)
+	}
+}
+
+func TestMetNoFetchRetriesTransientFailures(t *testing.T) {
+	var hits int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		if hits < 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(metNoFixture))
+	}))
+	defer server.Close()
+
+	p := &MetNo{BaseURL: server.URL, UserAgent: "WeatherService/1.0 test@example.com", Client: server.Client()}
+	_, err := p.Fetch(context.Background(), 59.9, 10.7, "metric")
+	if err != nil {
+		t.Fatalf("Fetch returned error: %v", err)
+	}
+	if hits != 2 {
+		t.Fatalf("expected a transient 503 to be retried, got %d attempts", hits)
+	}
+}
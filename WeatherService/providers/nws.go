@@ -0,0 +1,304 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/Edmond-Underwood/WeatherService/cache"
+	"github.com/Edmond-Underwood/WeatherService/metrics"
+)
+
+// NWSForecastURI is the api.weather.gov points lookup used to resolve a
+// lat/lon into a forecast URL.
+const NWSForecastURI = "https://api.weather.gov/points/"
+
+// DefaultCacheTTL is used when the upstream response doesn't send its own
+// Cache-Control/Expires header.
+const DefaultCacheTTL = 10 * time.Minute
+
+// DefaultTimeout bounds a single api.weather.gov request (including
+// retries) when NWS.Timeout isn't set.
+const DefaultTimeout = 10 * time.Second
+
+// nwsAccept is the media type api.weather.gov expects callers to ask for.
+const nwsAccept = "application/geo+json"
+
+// nwsPeriod mirrors one entry of a NWS periods array, used by both the
+// daily/nightly forecast and the hourly forecast endpoints.
+type nwsPeriod struct {
+	Name             string    `json:"name"`             // name of the forecast period
+	StartTime        time.Time `json:"startTime"`        // period start
+	EndTime          time.Time `json:"endTime"`          // period end
+	Temperature      int       `json:"temperature"`      // current temperature
+	TemperatureUnit  string    `json:"temperatureUnit"`  // unit of temperature
+	WindSpeed        string    `json:"windSpeed"`        // e.g. "10 mph"
+	WindDirection    string    `json:"windDirection"`    // e.g. "SW"
+	ShortForecast    string    `json:"shortForecast"`    // short forecast text
+	DetailedForecast string    `json:"detailedForecast"` // detailed forecast text
+}
+
+// nwsForecastData holds the forecast information
+type nwsForecastData struct {
+	Properties struct {
+		Periods []nwsPeriod `json:"periods"`
+	} `json:"properties"`
+}
+
+// nwsPointsResp holds the response from the points API
+type nwsPointsResp struct {
+	Properties struct {
+		Forecast       string `json:"forecast"`       // URL to the daily/nightly forecast data
+		ForecastHourly string `json:"forecastHourly"` // URL to the hourly forecast data
+	} `json:"properties"`
+}
+
+// NWS is the default Provider, backed by api.weather.gov. It only covers
+// US locations; outside the US the points lookup returns no forecast URL.
+//
+// The points→forecastURL mapping and the forecast bodies themselves are
+// both cacheable: NWS's grid is coarse enough that many nearby lat/lons
+// share a gridpoint, and a gridpoint's forecast is stable for a while.
+// Cache and Metrics are both optional; a zero-value NWS behaves exactly
+// like an uncached provider.
+type NWS struct {
+	BaseURL   string // overridable in tests; defaults to NWSForecastURI
+	Client    *http.Client
+	UserAgent string        // required by api.weather.gov; see NewCachedNWS
+	Timeout   time.Duration // default DefaultTimeout
+
+	Cache       cache.Cache
+	Metrics     *metrics.CacheCounters
+	PointsTTL   time.Duration // default DefaultCacheTTL
+	ForecastTTL time.Duration // default DefaultCacheTTL
+}
+
+// NewNWS builds an NWS provider with no caching. userAgent should
+// identify the calling application and a contact, per api.weather.gov's
+// usage terms; requests without one may be blocked.
+func NewNWS(userAgent string) *NWS {
+	return &NWS{BaseURL: NWSForecastURI, UserAgent: userAgent}
+}
+
+// NewCachedNWS builds an NWS provider that caches points and forecast
+// lookups in c, using DefaultCacheTTL unless the upstream response says
+// otherwise. userAgent should identify the calling application and a
+// contact, per api.weather.gov's usage terms; requests without one may
+// be blocked.
+func NewCachedNWS(c cache.Cache, userAgent string) *NWS {
+	return &NWS{
+		BaseURL:     NWSForecastURI,
+		UserAgent:   userAgent,
+		Cache:       c,
+		Metrics:     &metrics.CacheCounters{},
+		PointsTTL:   DefaultCacheTTL,
+		ForecastTTL: DefaultCacheTTL,
+	}
+}
+
+func (p *NWS) client() *http.Client {
+	if p.Client != nil {
+		return p.Client
+	}
+	return &http.Client{Timeout: p.timeout()}
+}
+
+func (p *NWS) timeout() time.Duration {
+	if p.Timeout > 0 {
+		return p.Timeout
+	}
+	return DefaultTimeout
+}
+
+func (p *NWS) baseURL() string {
+	if p.BaseURL != "" {
+		return p.BaseURL
+	}
+	return NWSForecastURI
+}
+
+// getJSON fetches url and decodes it into out. When cacheKey is
+// non-empty and a Cache is configured, it's consulted first (unless the
+// context carries cache.Bypassed) and populated afterward, honoring
+// whichever TTL the upstream response asks for.
+func (p *NWS) getJSON(ctx context.Context, url, cacheKey string, ttl time.Duration, out interface{}) error {
+	if p.Cache != nil && cacheKey != "" && !cache.Bypassed(ctx) {
+		if body, ok := p.Cache.Get(ctx, cacheKey); ok {
+			if p.Metrics != nil {
+				p.Metrics.Hit()
+			}
+			return json.Unmarshal(body, out)
+		}
+	}
+	if p.Metrics != nil && p.Cache != nil && cacheKey != "" {
+		p.Metrics.Miss()
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.timeout())
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("nws: building request: %w", err)
+	}
+	req.Header.Set("User-Agent", p.UserAgent)
+	req.Header.Set("Accept", nwsAccept)
+
+	resp, err := doWithRetry(ctx, p.client(), req)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrUnavailable, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return withProblem(resp, ErrNotFound)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return withProblem(resp, fmt.Errorf("%w: nws returned %d", ErrUnavailable, resp.StatusCode))
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("nws: reading response: %w", err)
+	}
+
+	if p.Cache != nil && cacheKey != "" {
+		p.Cache.Set(ctx, cacheKey, body, ttlFromResponse(resp, ttl))
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("nws: decoding response: %w", err)
+	}
+	return nil
+}
+
+func (p *NWS) pointsTTL() time.Duration {
+	if p.PointsTTL > 0 {
+		return p.PointsTTL
+	}
+	return DefaultCacheTTL
+}
+
+func (p *NWS) forecastTTL() time.Duration {
+	if p.ForecastTTL > 0 {
+		return p.ForecastTTL
+	}
+	return DefaultCacheTTL
+}
+
+// points resolves lat/lon to the gridpoint's forecast and forecastHourly
+// URLs. lat/lon are rounded to 2 decimal degrees (~1km) for the cache
+// key, since nearby coordinates share the same coarse NWS grid cell.
+func (p *NWS) points(ctx context.Context, lat, lon float64) (nwsPointsResp, error) {
+	pointsURL := fmt.Sprintf("%s/%f,%f", p.baseURL(), lat, lon)
+	cacheKey := fmt.Sprintf("nws:points:%.2f,%.2f", lat, lon)
+
+	var pointsResp nwsPointsResp
+	if err := p.getJSON(ctx, pointsURL, cacheKey, p.pointsTTL(), &pointsResp); err != nil {
+		return nwsPointsResp{}, err
+	}
+	return pointsResp, nil
+}
+
+func (p *NWS) getForecast(ctx context.Context, forecastURL string) (nwsForecastData, error) {
+	var forecastData nwsForecastData
+	cacheKey := "nws:forecast:" + forecastURL
+	if err := p.getJSON(ctx, forecastURL, cacheKey, p.forecastTTL(), &forecastData); err != nil {
+		return nwsForecastData{}, err
+	}
+	return forecastData, nil
+}
+
+func (p *NWS) Fetch(ctx context.Context, lat, lon float64, units string) (Forecast, error) {
+	pointsResp, err := p.points(ctx, lat, lon)
+	if err != nil {
+		return Forecast{}, err
+	}
+	if pointsResp.Properties.Forecast == "" {
+		return Forecast{}, ErrNotFound
+	}
+
+	forecastData, err := p.getForecast(ctx, pointsResp.Properties.Forecast)
+	if err != nil {
+		return Forecast{}, err
+	}
+	if len(forecastData.Properties.Periods) == 0 {
+		return Forecast{}, ErrNotFound
+	}
+
+	period := forecastData.Properties.Periods[0]
+	toUnit := unitOf(units)
+	return Forecast{
+		TempValue: convertTemp(float64(period.Temperature), period.TemperatureUnit, toUnit),
+		TempUnit:  toUnit,
+		Text:      period.DetailedForecast,
+	}, nil
+}
+
+func (p *NWS) FetchPeriods(ctx context.Context, lat, lon float64, units string, days int) ([]Period, error) {
+	pointsResp, err := p.points(ctx, lat, lon)
+	if err != nil {
+		return nil, err
+	}
+	if pointsResp.Properties.Forecast == "" {
+		return nil, ErrNotFound
+	}
+
+	forecastData, err := p.getForecast(ctx, pointsResp.Properties.Forecast)
+	if err != nil {
+		return nil, err
+	}
+	if len(forecastData.Properties.Periods) == 0 {
+		return nil, ErrNotFound
+	}
+
+	// NWS alternates day/night periods, so 1 "day" covers 2 periods.
+	limit := days * 2
+	if limit <= 0 || limit > len(forecastData.Properties.Periods) {
+		limit = len(forecastData.Properties.Periods)
+	}
+	return periodsToDomain(forecastData.Properties.Periods[:limit], units), nil
+}
+
+func (p *NWS) FetchHourly(ctx context.Context, lat, lon float64, units string) ([]Period, error) {
+	pointsResp, err := p.points(ctx, lat, lon)
+	if err != nil {
+		return nil, err
+	}
+	if pointsResp.Properties.ForecastHourly == "" {
+		return nil, ErrNotFound
+	}
+
+	forecastData, err := p.getForecast(ctx, pointsResp.Properties.ForecastHourly)
+	if err != nil {
+		return nil, err
+	}
+	if len(forecastData.Properties.Periods) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return periodsToDomain(forecastData.Properties.Periods, units), nil
+}
+
+func periodsToDomain(periods []nwsPeriod, units string) []Period {
+	toUnit := unitOf(units)
+	out := make([]Period, len(periods))
+	for i, period := range periods {
+		wind := period.WindSpeed
+		if period.WindDirection != "" {
+			wind = fmt.Sprintf("%s %s", period.WindSpeed, period.WindDirection)
+		}
+		out[i] = Period{
+			Name:             period.Name,
+			StartTime:        period.StartTime,
+			EndTime:          period.EndTime,
+			TempValue:        convertTemp(float64(period.Temperature), period.TemperatureUnit, toUnit),
+			TempUnit:         toUnit,
+			Wind:             wind,
+			ShortForecast:    period.ShortForecast,
+			DetailedForecast: period.DetailedForecast,
+		}
+	}
+	return out
+}
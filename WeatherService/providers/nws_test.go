@@ -0,0 +1,140 @@
+package providers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// Fixtures recorded from api.weather.gov, trimmed to the fields this
+// provider reads.
+const nwsPointsFixture = `{
+	"properties": {
+		"forecast": "%s/forecast"
+	}
+}`
+
+const nwsForecastFixture = `{
+	"properties": {
+		"periods": [
+			{
+				"name": "Tonight",
+				"detailedForecast": "Clear, with a low around 52.",
+				"temperature": 52,
+				"temperatureUnit": "F"
+			}
+		]
+	}
+}`
+
+const nwsMultiPeriodFixture = `{
+	"properties": {
+		"periods": [
+			{"name": "Today", "startTime": "2026-01-01T06:00:00-05:00", "endTime": "2026-01-01T18:00:00-05:00", "temperature": 60, "temperatureUnit": "F", "windSpeed": "10 mph", "windDirection": "SW", "shortForecast": "Sunny", "detailedForecast": "Sunny."},
+			{"name": "Tonight", "startTime": "2026-01-01T18:00:00-05:00", "endTime": "2026-01-02T06:00:00-05:00", "temperature": 40, "temperatureUnit": "F", "windSpeed": "5 mph", "windDirection": "S", "shortForecast": "Clear", "detailedForecast": "Clear."},
+			{"name": "Tomorrow", "startTime": "2026-01-02T06:00:00-05:00", "endTime": "2026-01-02T18:00:00-05:00", "temperature": 65, "temperatureUnit": "F", "windSpeed": "8 mph", "windDirection": "W", "shortForecast": "Sunny", "detailedForecast": "Sunny."}
+		]
+	}
+}`
+
+const nwsHourlyFixture = `{
+	"properties": {
+		"periods": [
+			{"name": "", "startTime": "2026-01-01T06:00:00-05:00", "endTime": "2026-01-01T07:00:00-05:00", "temperature": 50, "temperatureUnit": "F", "windSpeed": "5 mph", "windDirection": "SW", "shortForecast": "Sunny", "detailedForecast": "Sunny."},
+			{"name": "", "startTime": "2026-01-01T07:00:00-05:00", "endTime": "2026-01-01T08:00:00-05:00", "temperature": 52, "temperatureUnit": "F", "windSpeed": "5 mph", "windDirection": "SW", "shortForecast": "Sunny", "detailedForecast": "Sunny."}
+		]
+	}
+}`
+
+func TestNWSFetch(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/geo+json")
+		w.Write([]byte(fmt.Sprintf(nwsPointsFixture, server.URL)))
+	})
+	mux.HandleFunc("/forecast", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(nwsForecastFixture))
+	})
+
+	p := &NWS{BaseURL: server.URL, Client: server.Client()}
+	fc, err := p.Fetch(context.Background(), 39.7456, -97.0892, "imperial")
+	if err != nil {
+		t.Fatalf("Fetch returned error: %v", err)
+	}
+	if fc.TempValue != 52 || fc.TempUnit != "F" {
+		t.Fatalf("unexpected forecast: %+v", fc)
+	}
+	if fc.Text != "Clear, with a low around 52." {
+		t.Fatalf("unexpected forecast text: %q", fc.Text)
+	}
+}
+
+func TestNWSFetchPeriods(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(fmt.Sprintf(nwsPointsFixture, server.URL)))
+	})
+	mux.HandleFunc("/forecast", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(nwsMultiPeriodFixture))
+	})
+
+	p := &NWS{BaseURL: server.URL, Client: server.Client()}
+	periods, err := p.FetchPeriods(context.Background(), 39.7456, -97.0892, "imperial", 1)
+	if err != nil {
+		t.Fatalf("FetchPeriods returned error: %v", err)
+	}
+	// 1 day covers 2 alternating day/night periods.
+	if len(periods) != 2 {
+		t.Fatalf("expected 2 periods for days=1, got %d", len(periods))
+	}
+	if periods[0].Name != "Today" || periods[0].Wind != "10 mph SW" {
+		t.Fatalf("unexpected first period: %+v", periods[0])
+	}
+}
+
+func TestNWSFetchHourly(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	pointsFixture := `{"properties": {"forecast": "%s/forecast", "forecastHourly": "%s/hourly"}}`
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(fmt.Sprintf(pointsFixture, server.URL, server.URL)))
+	})
+	mux.HandleFunc("/hourly", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(nwsHourlyFixture))
+	})
+
+	p := &NWS{BaseURL: server.URL, Client: server.Client()}
+	periods, err := p.FetchHourly(context.Background(), 39.7456, -97.0892, "metric")
+	if err != nil {
+		t.Fatalf("FetchHourly returned error: %v", err)
+	}
+	if len(periods) != 2 {
+		t.Fatalf("expected 2 hourly periods, got %d", len(periods))
+	}
+	if periods[0].TempUnit != "C" {
+		t.Fatalf("expected conversion to Celsius, got unit %q", periods[0].TempUnit)
+	}
+}
+
+func TestNWSFetchNoForecastURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"properties": {"forecast": ""}}`))
+	}))
+	defer server.Close()
+
+	p := &NWS{BaseURL: server.URL, Client: server.Client()}
+	_, err := p.Fetch(context.Background(), 0, 0, "imperial")
+	if err != ErrNotFound {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
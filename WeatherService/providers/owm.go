@@ -0,0 +1,193 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// OWMBaseURL is the OpenWeatherMap current-weather endpoint.
+const OWMBaseURL = "https://api.openweathermap.org/data/2.5/weather"
+
+// OWMForecastURL is OpenWeatherMap's 5-day/3-hour forecast endpoint.
+const OWMForecastURL = "https://api.openweathermap.org/data/2.5/forecast"
+
+// owmResponse holds the subset of the OpenWeatherMap current-weather
+// response we care about.
+type owmResponse struct {
+	Weather []owmWeather `json:"weather"`
+	Main    struct {
+		Temp float64 `json:"temp"` // current temperature, in the units the request asked for
+	} `json:"main"`
+}
+
+type owmWeather struct {
+	Description string `json:"description"` // short, human-readable forecast text
+}
+
+// owmForecastResponse holds the subset of OpenWeatherMap's 5-day/3-hour
+// forecast response we care about.
+type owmForecastResponse struct {
+	List []struct {
+		Dt   int64 `json:"dt"` // Unix timestamp of this 3-hour slot
+		Main struct {
+			Temp float64 `json:"temp"`
+		} `json:"main"`
+		Weather []owmWeather `json:"weather"`
+		Wind    struct {
+			Speed float64 `json:"speed"`
+		} `json:"wind"`
+	} `json:"list"`
+}
+
+// OpenWeatherMap is a Provider backed by OpenWeatherMap's current-weather
+// and forecast APIs, keyed by an API key (see https://openweathermap.org/appid).
+type OpenWeatherMap struct {
+	BaseURL         string // overridable in tests; defaults to OWMBaseURL
+	ForecastBaseURL string // overridable in tests; defaults to OWMForecastURL
+	APIKey          string
+	UserAgent       string // identifies the calling application and a contact
+	Client          *http.Client
+	Timeout         time.Duration // default DefaultTimeout
+}
+
+// NewOpenWeatherMap builds an OpenWeatherMap provider using the given API
+// key (typically read from the OWM_API_KEY environment variable) and
+// User-Agent, identifying the calling application and a contact.
+func NewOpenWeatherMap(apiKey, userAgent string) *OpenWeatherMap {
+	return &OpenWeatherMap{
+		BaseURL:         OWMBaseURL,
+		ForecastBaseURL: OWMForecastURL,
+		APIKey:          apiKey,
+		UserAgent:       userAgent,
+	}
+}
+
+func (p *OpenWeatherMap) client() *http.Client {
+	if p.Client != nil {
+		return p.Client
+	}
+	return &http.Client{Timeout: p.timeout()}
+}
+
+func (p *OpenWeatherMap) timeout() time.Duration {
+	if p.Timeout > 0 {
+		return p.Timeout
+	}
+	return DefaultTimeout
+}
+
+func owmUnitsParam(units string) (owmUnits, tempUnit string) {
+	if units == "metric" {
+		return "metric", "C"
+	}
+	return "imperial", "F"
+}
+
+func (p *OpenWeatherMap) getJSON(ctx context.Context, url string, out interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout())
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("owm: building request: %w", err)
+	}
+	if p.UserAgent != "" {
+		req.Header.Set("User-Agent", p.UserAgent)
+	}
+
+	resp, err := doWithRetry(ctx, p.client(), req)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrUnavailable, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: owm returned %d", ErrUnavailable, resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("owm: decoding response: %w", err)
+	}
+	return nil
+}
+
+func (p *OpenWeatherMap) Fetch(ctx context.Context, lat, lon float64, units string) (Forecast, error) {
+	baseURL := p.BaseURL
+	if baseURL == "" {
+		baseURL = OWMBaseURL
+	}
+	owmUnits, tempUnit := owmUnitsParam(units)
+	url := fmt.Sprintf("%s?lat=%f&lon=%f&units=%s&appid=%s", baseURL, lat, lon, owmUnits, p.APIKey)
+
+	var owmResp owmResponse
+	if err := p.getJSON(ctx, url, &owmResp); err != nil {
+		return Forecast{}, err
+	}
+
+	text := ""
+	if len(owmResp.Weather) > 0 {
+		text = owmResp.Weather[0].Description
+	}
+
+	return Forecast{
+		TempValue: owmResp.Main.Temp,
+		TempUnit:  tempUnit,
+		Text:      text,
+	}, nil
+}
+
+// FetchPeriods uses the 3-hour/5-day forecast endpoint and keeps the
+// first (UTC calendar day, chronologically earliest) slot seen per day to
+// approximate the "daily period" shape the rest of the service expects.
+func (p *OpenWeatherMap) FetchPeriods(ctx context.Context, lat, lon float64, units string, days int) ([]Period, error) {
+	baseURL := p.ForecastBaseURL
+	if baseURL == "" {
+		baseURL = OWMForecastURL
+	}
+	owmUnits, tempUnit := owmUnitsParam(units)
+	url := fmt.Sprintf("%s?lat=%f&lon=%f&units=%s&appid=%s", baseURL, lat, lon, owmUnits, p.APIKey)
+
+	var forecastResp owmForecastResponse
+	if err := p.getJSON(ctx, url, &forecastResp); err != nil {
+		return nil, err
+	}
+	if len(forecastResp.List) == 0 {
+		return nil, ErrNotFound
+	}
+
+	var out []Period
+	lastDay := -1
+	for _, slot := range forecastResp.List {
+		t := time.Unix(slot.Dt, 0).UTC()
+		if t.Day() == lastDay {
+			continue // keep the first slot seen for each day
+		}
+		lastDay = t.Day()
+
+		text := ""
+		if len(slot.Weather) > 0 {
+			text = slot.Weather[0].Description
+		}
+		windSpeedUnit := "mph"
+		if units == "metric" {
+			windSpeedUnit = "m/s"
+		}
+		out = append(out, Period{
+			Name:             t.Format("Monday"),
+			StartTime:        t,
+			EndTime:          t.Add(3 * time.Hour),
+			TempValue:        slot.Main.Temp,
+			TempUnit:         tempUnit,
+			Wind:             fmt.Sprintf("%g %s", slot.Wind.Speed, windSpeedUnit),
+			ShortForecast:    text,
+			DetailedForecast: text,
+		})
+		if days > 0 && len(out) >= days {
+			break
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,103 @@
+package providers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// Fixture recorded from api.openweathermap.org/data/2.5/weather, trimmed
+// to the fields this provider reads.
+const owmFixture = `{
+	"weather": [{"description": "scattered clouds"}],
+	"main": {"temp": 68.4},
+	"cod": 200
+}`
+
+func TestOpenWeatherMapFetch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(owmFixture))
+	}))
+	defer server.Close()
+
+	p := &OpenWeatherMap{BaseURL: server.URL, APIKey: "test-key", Client: server.Client()}
+	fc, err := p.Fetch(context.Background(), 51.5, -0.1, "imperial")
+	if err != nil {
+		t.Fatalf("Fetch returned error: %v", err)
+	}
+	if fc.TempValue != 68.4 || fc.TempUnit != "F" {
+		t.Fatalf("unexpected forecast: %+v", fc)
+	}
+	if fc.Text != "scattered clouds" {
+		t.Fatalf("unexpected forecast text: %q", fc.Text)
+	}
+}
+
+// Fixture recorded from api.openweathermap.org/data/2.5/forecast, trimmed
+// to the fields this provider reads. Two 3-hour slots the same day plus
+// one the next day.
+const owmForecastFixture = `{
+	"list": [
+		{"dt": 1735732800, "main": {"temp": 60}, "weather": [{"description": "clear sky"}], "wind": {"speed": 5}},
+		{"dt": 1735743600, "main": {"temp": 65}, "weather": [{"description": "clear sky"}], "wind": {"speed": 6}},
+		{"dt": 1735819200, "main": {"temp": 58}, "weather": [{"description": "light rain"}], "wind": {"speed": 4}}
+	]
+}`
+
+func TestOpenWeatherMapFetchPeriods(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(owmForecastFixture))
+	}))
+	defer server.Close()
+
+	p := &OpenWeatherMap{ForecastBaseURL: server.URL, APIKey: "test-key", Client: server.Client()}
+	periods, err := p.FetchPeriods(context.Background(), 51.5, -0.1, "imperial", 2)
+	if err != nil {
+		t.Fatalf("FetchPeriods returned error: %v", err)
+	}
+	if len(periods) != 2 {
+		t.Fatalf("expected 2 periods (one per day), got %d", len(periods))
+	}
+	if periods[0].TempValue != 60 {
+		t.Fatalf("expected first slot of the day to be kept, got %+v", periods[0])
+	}
+}
+
+func TestOpenWeatherMapFetchNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	p := &OpenWeatherMap{BaseURL: server.URL, APIKey: "test-key", Client: server.Client()}
+	_, err := p.Fetch(context.Background(), 0, 0, "imperial")
+	if err != ErrNotFound {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestOpenWeatherMapFetchSetsUserAgentAndRetries(t *testing.T) {
+	var hits int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		if r.Header.Get("User-Agent") == "" {
+			t.Error("expected User-Agent header on request")
+		}
+		if hits < 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(owmFixture))
+	}))
+	defer server.Close()
+
+	p := &OpenWeatherMap{BaseURL: server.URL, APIKey: "test-key", UserAgent: "WeatherService/1.0 test@example.com", Client: server.Client()}
+	_, err := p.Fetch(context.Background(), 51.5, -0.1, "imperial")
+	if err != nil {
+		t.Fatalf("Fetch returned error: %v", err)
+	}
+	if hits != 2 {
+		t.Fatalf("expected a transient 503 to be retried, got %d attempts", hits)
+	}
+}
@@ -0,0 +1,67 @@
+package providers
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// problemContentType is the media type RFC 7807 error bodies are served
+// as. NWS uses it for every non-2xx api.weather.gov response.
+const problemContentType = "application/problem+json"
+
+// Problem is an upstream RFC 7807 problem+json body, decoded so callers
+// can surface richer diagnostics than the ErrNotFound/ErrUnavailable
+// sentinels alone.
+type Problem struct {
+	Type          string `json:"type"`
+	Title         string `json:"title"`
+	Detail        string `json:"detail"`
+	CorrelationID string `json:"correlationId"`
+}
+
+// problemError pairs a sentinel (ErrNotFound/ErrUnavailable, possibly
+// already wrapped with more context) with the upstream Problem body that
+// explains it.
+type problemError struct {
+	cause   error
+	problem *Problem
+}
+
+func (e *problemError) Error() string { return e.cause.Error() }
+func (e *problemError) Unwrap() error { return e.cause }
+
+// ProblemFrom extracts the upstream Problem carried by err, if any.
+func ProblemFrom(err error) *Problem {
+	for err != nil {
+		if pe, ok := err.(*problemError); ok {
+			return pe.problem
+		}
+		unwrapper, ok := err.(interface{ Unwrap() error })
+		if !ok {
+			return nil
+		}
+		err = unwrapper.Unwrap()
+	}
+	return nil
+}
+
+// withProblem wraps cause with resp's body when it's a problem+json
+// document, so the caller doesn't lose NWS's title/detail/correlationId.
+// cause is returned unchanged if resp isn't problem+json or fails to
+// decode.
+func withProblem(resp *http.Response, cause error) error {
+	if !strings.Contains(resp.Header.Get("Content-Type"), problemContentType) {
+		return cause
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return cause
+	}
+	var problem Problem
+	if err := json.Unmarshal(body, &problem); err != nil {
+		return cause
+	}
+	return &problemError{cause: cause, problem: &problem}
+}
@@ -0,0 +1,56 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const nwsProblemFixture = `{
+	"type": "https://api.weather.gov/problems/InvalidPoint",
+	"title": "Data Unavailable For Requested Point",
+	"detail": "Unable to provide data for requested point 1,1",
+	"correlationId": "abc-123"
+}`
+
+func TestNWSFetchSurfacesProblemDetails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(nwsProblemFixture))
+	}))
+	defer server.Close()
+
+	p := &NWS{BaseURL: server.URL, Client: server.Client()}
+	_, err := p.Fetch(context.Background(), 1, 1, "imperial")
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+
+	problem := ProblemFrom(err)
+	if problem == nil {
+		t.Fatal("expected a Problem to be attached to the error")
+	}
+	if problem.Title != "Data Unavailable For Requested Point" || problem.CorrelationID != "abc-123" {
+		t.Fatalf("unexpected problem details: %+v", problem)
+	}
+}
+
+func TestNWSFetchIgnoresNonProblemBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("not found"))
+	}))
+	defer server.Close()
+
+	p := &NWS{BaseURL: server.URL, Client: server.Client()}
+	_, err := p.Fetch(context.Background(), 1, 1, "imperial")
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	if problem := ProblemFrom(err); problem != nil {
+		t.Fatalf("expected no Problem for a plain-text body, got %+v", problem)
+	}
+}
@@ -0,0 +1,117 @@
+// Package providers defines the pluggable upstream weather sources
+// (NWS, OpenWeatherMap, MET Norway) behind a single Provider interface,
+// so the rest of the service doesn't care which one answered a request.
+package providers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// Forecast is the normalized shape every Provider returns, regardless of
+// how the upstream API shaped its response.
+type Forecast struct {
+	TempValue float64 // numeric temperature, converted into TempUnit if the upstream reported another unit
+	TempUnit  string  // "F" or "C"
+	Text      string  // detailed forecast text
+}
+
+// Period is one entry of a multi-period or hourly forecast: a forecast
+// for a single day, night, or hour, depending on which method returned
+// it.
+type Period struct {
+	Name             string // e.g. "Tonight", "Wednesday"
+	StartTime        time.Time
+	EndTime          time.Time
+	TempValue        float64
+	TempUnit         string // "F" or "C"
+	Wind             string // e.g. "10 mph SW"
+	ShortForecast    string
+	DetailedForecast string
+}
+
+// Provider fetches a forecast for a location from a single upstream
+// weather API. units is "imperial" or "metric"; a provider that can't
+// natively serve the requested units converts before returning.
+type Provider interface {
+	Fetch(ctx context.Context, lat, lon float64, units string) (Forecast, error)
+
+	// FetchPeriods returns forecast periods covering up to days days.
+	// Providers that split each day into day and night periods (NWS)
+	// return up to 2*days entries; the others return one per day.
+	FetchPeriods(ctx context.Context, lat, lon float64, units string, days int) ([]Period, error)
+}
+
+// unitOf maps the "imperial"/"metric" query param to the "F"/"C" unit
+// abbreviation providers report temperatures in.
+func unitOf(units string) string {
+	if units == "metric" {
+		return "C"
+	}
+	return "F"
+}
+
+// convertTemp converts a temperature reported in fromUnit ("F" or "C")
+// into toUnit, leaving it untouched if they already match.
+func convertTemp(value float64, fromUnit, toUnit string) float64 {
+	if fromUnit == toUnit {
+		return value
+	}
+	if toUnit == "C" {
+		return (value - 32) * 5 / 9
+	}
+	return value*9/5 + 32
+}
+
+// HourlyProvider is implemented by providers that can additionally serve
+// an hour-by-hour forecast. Not every Provider can: NWS is the only one
+// wired up to /GetHourlyForecast today, via the points response's
+// ForecastHourly URL.
+type HourlyProvider interface {
+	FetchHourly(ctx context.Context, lat, lon float64, units string) ([]Period, error)
+}
+
+// retryAttempts is how many extra tries doWithRetry makes after an
+// initial 429/5xx response, before giving up and returning it anyway.
+const retryAttempts = 3
+
+// retryBaseDelay is the starting exponential-backoff delay between
+// retries; it doubles after each attempt.
+const retryBaseDelay = 200 * time.Millisecond
+
+// doWithRetry performs req via client, retrying with exponential backoff
+// when the upstream responds 429 or 5xx. req must have a nil body (or one
+// safe to resend) since it may be sent more than once. It gives up early
+// if ctx is canceled between attempts.
+func doWithRetry(ctx context.Context, client *http.Client, req *http.Request) (*http.Response, error) {
+	var resp *http.Response
+	var err error
+	delay := retryBaseDelay
+	for attempt := 0; ; attempt++ {
+		resp, err = client.Do(req)
+		if err != nil || resp.StatusCode < http.StatusInternalServerError && resp.StatusCode != http.StatusTooManyRequests {
+			return resp, err
+		}
+		if attempt == retryAttempts {
+			return resp, err
+		}
+		resp.Body.Close()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// Sentinel errors providers return so callers can map them to
+// transport-specific statuses (HTTP codes, gRPC codes) without parsing
+// messages.
+var (
+	ErrNotFound    = errors.New("providers: no forecast available for location")
+	ErrUnavailable = errors.New("providers: upstream service unavailable")
+)
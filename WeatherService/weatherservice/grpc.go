@@ -0,0 +1,97 @@
+package weatherservice
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Edmond-Underwood/WeatherService/geocoder"
+	"github.com/Edmond-Underwood/WeatherService/pb"
+)
+
+// GRPCServer implements pb.WeatherServiceServer, resolving ZIP/city
+// locations via Geocoder before handing lat/lon off to the Service.
+type GRPCServer struct {
+	pb.UnimplementedWeatherServiceServer
+	Service  *Service
+	Geocoder geocoder.Geocoder
+}
+
+// NewGRPCServer builds a GRPCServer. geo may be nil if only lat/lon
+// requests will be served; ZIP/city requests will then fail with
+// InvalidArgument.
+func NewGRPCServer(svc *Service, geo geocoder.Geocoder) *GRPCServer {
+	return &GRPCServer{Service: svc, Geocoder: geo}
+}
+
+func (s *GRPCServer) GetWeather(ctx context.Context, req *pb.GetWeatherRequest) (*pb.GetWeatherResponse, error) {
+	lat, lon, err := s.resolveLocation(ctx, req.GetLocation())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.Service.Fetch(ctx, s.Service.Default, lat, lon, "imperial")
+	if err != nil {
+		return nil, toGRPCStatus(err)
+	}
+
+	return resultToProto(result), nil
+}
+
+func (s *GRPCServer) resolveLocation(ctx context.Context, loc *pb.OneOfLocation) (lat, lon float64, err error) {
+	if loc == nil {
+		return 0, 0, status.Error(codes.InvalidArgument, "location is required")
+	}
+
+	switch l := loc.Location.(type) {
+	case *pb.OneOfLocation_LatLon:
+		return l.LatLon.GetLat(), l.LatLon.GetLon(), nil
+	case *pb.OneOfLocation_Zip:
+		return s.geocode(ctx, l.Zip)
+	case *pb.OneOfLocation_City:
+		return s.geocode(ctx, l.City)
+	default:
+		return 0, 0, status.Error(codes.InvalidArgument, "location must set lat_lon, zip, or city")
+	}
+}
+
+func (s *GRPCServer) geocode(ctx context.Context, query string) (lat, lon float64, err error) {
+	if s.Geocoder == nil {
+		return 0, 0, status.Error(codes.InvalidArgument, "ZIP/city lookup is not configured on this server")
+	}
+	lat, lon, err = s.Geocoder.Geocode(ctx, query)
+	if err != nil {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "could not resolve location %q: %v", query, err)
+	}
+	return lat, lon, nil
+}
+
+// toGRPCStatus maps the opaque *Error produced by Fetch onto the gRPC
+// status codes its caller expects, instead of leaking HTTP status
+// numbers across the RPC boundary.
+func toGRPCStatus(err error) error {
+	svcErr, ok := err.(*Error)
+	if !ok {
+		return status.Error(codes.Internal, err.Error())
+	}
+	switch svcErr.Status {
+	case 400:
+		return status.Error(codes.InvalidArgument, svcErr.Message)
+	case 404:
+		return status.Error(codes.NotFound, svcErr.Message)
+	case 502, 503, 504:
+		return status.Error(codes.Unavailable, svcErr.Message)
+	default:
+		return status.Error(codes.Internal, svcErr.Message)
+	}
+}
+
+func resultToProto(r Result) *pb.GetWeatherResponse {
+	return &pb.GetWeatherResponse{
+		Temperature: r.TempValue,
+		Unit:        r.TempUnit,
+		Bucket:      r.Bucket,
+		Forecast:    r.Forecast,
+	}
+}
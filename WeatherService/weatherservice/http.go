@@ -0,0 +1,187 @@
+package weatherservice
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/Edmond-Underwood/WeatherService/cache"
+	"github.com/Edmond-Underwood/WeatherService/providers"
+)
+
+// ErrorResponse holds the error schema. RequestID is generated per
+// request and logged alongside the failure, so a user reporting a bug
+// can be correlated with server logs. Upstream is only set when the
+// failing provider returned an RFC 7807 problem+json body.
+type ErrorResponse struct {
+	Code      int              `json:"code"`      // error code
+	Message   string           `json:"message"`   // error message
+	RequestID string           `json:"requestId"` // correlates this response with server logs
+	Upstream  *UpstreamProblem `json:"upstream,omitempty"`
+}
+
+// UpstreamProblem surfaces the title/detail/correlationId of an upstream
+// RFC 7807 problem+json body, so clients get better diagnostics than the
+// Message constants alone.
+type UpstreamProblem struct {
+	Title         string `json:"title,omitempty"`
+	Detail        string `json:"detail,omitempty"`
+	CorrelationID string `json:"correlationId,omitempty"`
+}
+
+func asUpstreamProblem(p *providers.Problem) *UpstreamProblem {
+	if p == nil {
+		return nil
+	}
+	return &UpstreamProblem{Title: p.Title, Detail: p.Detail, CorrelationID: p.CorrelationID}
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeErrorResponse(w, status, message, nil)
+}
+
+func writeServiceError(w http.ResponseWriter, err error) {
+	if svcErr, ok := err.(*Error); ok {
+		writeErrorResponse(w, svcErr.Status, svcErr.Message, svcErr.Upstream)
+		return
+	}
+	writeErrorResponse(w, http.StatusInternalServerError, err.Error(), nil)
+}
+
+func writeErrorResponse(w http.ResponseWriter, status int, message string, upstream *providers.Problem) {
+	requestID := newRequestID()
+	log.Printf("request %s failed: %d %s", requestID, status, message)
+
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(ErrorResponse{
+		Code:      status,
+		Message:   message,
+		RequestID: requestID,
+		Upstream:  asUpstreamProblem(upstream),
+	})
+}
+
+// parseLatLon reads and validates the lat/lon query parameters shared by
+// every forecast endpoint.
+func parseLatLon(r *http.Request) (lat, lon float64, err error) {
+	latStr := r.URL.Query().Get("lat")
+	lonStr := r.URL.Query().Get("lon")
+	if latStr == "" || lonStr == "" {
+		return 0, 0, newError(http.StatusBadRequest, MissingLatLon)
+	}
+
+	// Parse latitude and longitude data as decimal degree floating point (not EWLL or DMS)
+	lat, err1 := strconv.ParseFloat(latStr, 64)
+	lon, err2 := strconv.ParseFloat(lonStr, 64)
+	if err1 != nil || err2 != nil {
+		return 0, 0, newError(http.StatusBadRequest, InvalidLatLon)
+	}
+	return lat, lon, nil
+}
+
+// providerAndUnits reads the ?provider= and ?units= query parameters
+// shared by every forecast endpoint, applying the Service's default
+// provider and imperial units when unset.
+func (s *Service) providerAndUnits(r *http.Request) (providerName, units string) {
+	providerName = r.URL.Query().Get("provider")
+	if providerName == "" {
+		providerName = s.Default
+	}
+	units = r.URL.Query().Get("units")
+	if units == "" {
+		units = "imperial"
+	}
+	return providerName, units
+}
+
+// requestContext returns r.Context(), marked with cache.WithBypass when
+// the request set ?nocache=1.
+func requestContext(r *http.Request) context.Context {
+	if r.URL.Query().Get("nocache") == "1" {
+		return cache.WithBypass(r.Context())
+	}
+	return r.Context()
+}
+
+// GetWeather handles the weather requests
+// Standard Gorilla Mux router notation is used to handle the requests
+// and extract query parameters. The provider can be selected per request
+// via ?provider=, falling back to the Service default (NWS).
+func (s *Service) GetWeather(w http.ResponseWriter, r *http.Request) {
+	lat, lon, err := parseLatLon(r)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	providerName, units := s.providerAndUnits(r)
+
+	result, err := s.Fetch(requestContext(r), providerName, lat, lon, units)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result.AsWeatherData())
+}
+
+// GetForecast returns the forecast periods covering the next ?days= days
+// (default 7) for a location, honoring ?provider= and ?units=.
+func (s *Service) GetForecast(w http.ResponseWriter, r *http.Request) {
+	lat, lon, err := parseLatLon(r)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	providerName, units := s.providerAndUnits(r)
+
+	days := 7
+	if daysStr := r.URL.Query().Get("days"); daysStr != "" {
+		parsed, err := strconv.Atoi(daysStr)
+		if err != nil || parsed <= 0 {
+			writeError(w, http.StatusBadRequest, "Invalid days value")
+			return
+		}
+		days = parsed
+	}
+
+	periods, err := s.FetchPeriods(requestContext(r), providerName, lat, lon, units, days)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(periodsToJSON(periods))
+}
+
+// GetHourlyForecast returns the hour-by-hour forecast for a location,
+// honoring ?provider= and ?units=. Only providers implementing
+// providers.HourlyProvider (currently NWS) support this endpoint.
+func (s *Service) GetHourlyForecast(w http.ResponseWriter, r *http.Request) {
+	lat, lon, err := parseLatLon(r)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	providerName, units := s.providerAndUnits(r)
+
+	periods, err := s.FetchHourly(requestContext(r), providerName, lat, lon, units)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(periodsToJSON(periods))
+}
+
+func periodsToJSON(periods []PeriodResult) []PeriodJSON {
+	out := make([]PeriodJSON, len(periods))
+	for i, p := range periods {
+		out[i] = p.AsJSON()
+	}
+	return out
+}
@@ -0,0 +1,106 @@
+package weatherservice
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/Edmond-Underwood/WeatherService/providers"
+)
+
+// PeriodResult is one entry of a multi-period or hourly forecast,
+// classified into the Hot/Cold/Moderate buckets like Result.
+type PeriodResult struct {
+	Name             string
+	StartTime        time.Time
+	EndTime          time.Time
+	TempValue        float64
+	TempUnit         string
+	Bucket           string
+	Wind             string
+	ShortForecast    string
+	DetailedForecast string
+}
+
+// PeriodJSON is the wire shape returned by /GetForecast and
+// /GetHourlyForecast.
+type PeriodJSON struct {
+	Name             string `json:"name"`
+	StartTime        string `json:"startTime"`
+	EndTime          string `json:"endTime"`
+	Temperature      string `json:"temperature"`
+	Wind             string `json:"wind"`
+	ShortForecast    string `json:"shortForecast"`
+	DetailedForecast string `json:"detailedForecast"`
+}
+
+// AsJSON formats a PeriodResult into the wire shape returned by the
+// multi-period and hourly endpoints, matching the "Bucket (temp unit)"
+// convention Result.AsWeatherData uses for the single-period endpoint.
+func (r PeriodResult) AsJSON() PeriodJSON {
+	return PeriodJSON{
+		Name:             r.Name,
+		StartTime:        r.StartTime.Format(time.RFC3339),
+		EndTime:          r.EndTime.Format(time.RFC3339),
+		Temperature:      formatTemp(r.Bucket, r.TempValue, r.TempUnit),
+		Wind:             r.Wind,
+		ShortForecast:    r.ShortForecast,
+		DetailedForecast: r.DetailedForecast,
+	}
+}
+
+// FetchPeriods resolves lat/lon against the named provider and returns
+// its forecast periods covering up to days days (see
+// providers.Provider.FetchPeriods), each classified into the
+// Hot/Cold/Moderate buckets.
+func (s *Service) FetchPeriods(ctx context.Context, providerName string, lat, lon float64, units string, days int) ([]PeriodResult, error) {
+	p, err := s.provider(providerName)
+	if err != nil {
+		return nil, err
+	}
+
+	periods, err := p.FetchPeriods(ctx, lat, lon, units, days)
+	if err != nil {
+		return nil, mapProviderError(err)
+	}
+	return periodsToResults(periods), nil
+}
+
+// FetchHourly resolves lat/lon against the named provider's hourly
+// forecast. Providers that don't implement providers.HourlyProvider
+// (currently everything but NWS) return a 400.
+func (s *Service) FetchHourly(ctx context.Context, providerName string, lat, lon float64, units string) ([]PeriodResult, error) {
+	p, err := s.provider(providerName)
+	if err != nil {
+		return nil, err
+	}
+
+	hourly, ok := p.(providers.HourlyProvider)
+	if !ok {
+		return nil, newError(http.StatusBadRequest, "provider "+providerName+" does not support hourly forecasts")
+	}
+
+	periods, err := hourly.FetchHourly(ctx, lat, lon, units)
+	if err != nil {
+		return nil, mapProviderError(err)
+	}
+	return periodsToResults(periods), nil
+}
+
+func periodsToResults(periods []providers.Period) []PeriodResult {
+	out := make([]PeriodResult, len(periods))
+	for i, period := range periods {
+		out[i] = PeriodResult{
+			Name:             period.Name,
+			StartTime:        period.StartTime,
+			EndTime:          period.EndTime,
+			TempValue:        period.TempValue,
+			TempUnit:         period.TempUnit,
+			Bucket:           classify(period.TempValue, period.TempUnit),
+			Wind:             period.Wind,
+			ShortForecast:    period.ShortForecast,
+			DetailedForecast: period.DetailedForecast,
+		}
+	}
+	return out
+}
@@ -0,0 +1,17 @@
+package weatherservice
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+// newRequestID generates a short identifier for correlating a failed
+// request with server logs. It only needs to be distinct enough to grep
+// for in a log window, not globally unique.
+func newRequestID() string {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "unknown"
+	}
+	return fmt.Sprintf("%x", b)
+}
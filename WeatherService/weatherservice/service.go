@@ -0,0 +1,173 @@
+// Package weatherservice holds the forecast lookup logic shared by the
+// HTTP and gRPC transports. Transport-specific code (request parsing,
+// status codes, wire formats) lives in the callers; this package only
+// knows how to turn a location into weather data.
+package weatherservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/Edmond-Underwood/WeatherService/providers"
+)
+
+// Messages
+const (
+	MissingLatLon         = "Missing lat or lon query parameter"
+	InvalidLatLon         = "Invalid lat or lon value"
+	NoForecastURL         = "No forecast URL found for location"
+	FailedToGetForecast   = "Failed to get forecast"
+	FailedToParseForecast = "Failed to parse forecast response"
+	NoForecastData        = "No forecast data found"
+	UpstreamUnavailable   = "Upstream weather service is unavailable"
+)
+
+// Temperature
+const (
+	TempHot      = "Hot"
+	TempCold     = "Cold"
+	TempModerate = "Moderate"
+)
+
+// DefaultProvider is used when a request doesn't specify ?provider=.
+const DefaultProvider = "nws"
+
+// WeatherData holds the weather information
+type WeatherData struct {
+	Forecast    string `json:"forecast"`    // detailed forecast text
+	Temperature string `json:"temperature"` // current temperature
+}
+
+// Result is the raw shape of a single forecast lookup, before it's
+// formatted into the legacy WeatherData string. The gRPC transport sends
+// these fields individually; HTTP collapses them via AsWeatherData.
+type Result struct {
+	TempValue float64 // numeric temperature in TempUnit, before bucketing
+	TempUnit  string  // "F" or "C"
+	Bucket    string  // Hot, Cold, or Moderate
+	Forecast  string  // detailed forecast text
+}
+
+// AsWeatherData formats a Result into the string-based shape the HTTP API
+// has always returned.
+func (r Result) AsWeatherData() WeatherData {
+	return WeatherData{
+		Forecast:    r.Forecast,
+		Temperature: formatTemp(r.Bucket, r.TempValue, r.TempUnit),
+	}
+}
+
+// formatTemp renders a classified temperature as "Bucket (value unit)",
+// e.g. "Hot (85 F)".
+func formatTemp(bucket string, value float64, unit string) string {
+	return fmt.Sprintf("%s (%g %s)", bucket, value, unit)
+}
+
+// Error wraps a message (usually one of the constants above) so callers can tell
+// which stage of the lookup failed without string-matching. Upstream is
+// set when the failing provider surfaced an RFC 7807 problem+json body
+// (NWS does, on every error response), so transports can pass its
+// title/detail/correlationId through instead of only the opaque Message.
+type Error struct {
+	Message  string
+	Status   int // http.StatusXxx, used by the HTTP transport and mapped by the gRPC one
+	Upstream *providers.Problem
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func newError(status int, message string) *Error {
+	return &Error{Status: status, Message: message}
+}
+
+// Service dispatches forecast lookups to one of several pluggable
+// Providers, keyed by name (e.g. "nws", "owm", "metno").
+type Service struct {
+	Providers map[string]providers.Provider
+	Default   string
+}
+
+// NewService builds a Service. def is used whenever a caller doesn't
+// specify a provider, or specifies one that isn't registered.
+func NewService(ps map[string]providers.Provider, def string) *Service {
+	return &Service{Providers: ps, Default: def}
+}
+
+// Fetch resolves lat/lon against the named provider (falling back to the
+// Service default), and classifies the result into the Hot/Cold/Moderate
+// buckets.
+func (s *Service) Fetch(ctx context.Context, providerName string, lat, lon float64, units string) (Result, error) {
+	p, err := s.provider(providerName)
+	if err != nil {
+		return Result{}, err
+	}
+
+	fc, err := p.Fetch(ctx, lat, lon, units)
+	if err != nil {
+		return Result{}, mapProviderError(err)
+	}
+
+	return Result{
+		TempValue: fc.TempValue,
+		TempUnit:  fc.TempUnit,
+		Bucket:    classify(fc.TempValue, fc.TempUnit),
+		Forecast:  fc.Text,
+	}, nil
+}
+
+// provider resolves providerName against the Service default, or returns
+// the "no provider configured" Error if neither is registered.
+func (s *Service) provider(providerName string) (providers.Provider, error) {
+	p := s.Providers[providerName]
+	if p == nil {
+		p = s.Providers[s.Default]
+	}
+	if p == nil {
+		return nil, newError(http.StatusInternalServerError, "no weather provider configured")
+	}
+	return p, nil
+}
+
+// mapProviderError turns a providers.Err* sentinel into the HTTP-flavored
+// *Error the rest of this package expects, carrying along any upstream
+// problem+json body the provider attached. Status is chosen so the gRPC
+// transport's toGRPCStatus can tell "not found" and "upstream is down"
+// apart instead of collapsing both to Internal.
+func mapProviderError(err error) *Error {
+	e := newError(http.StatusInternalServerError, FailedToGetForecast)
+	switch {
+	case errors.Is(err, providers.ErrNotFound):
+		e = newError(http.StatusNotFound, NoForecastData)
+	case errors.Is(err, providers.ErrUnavailable):
+		e = newError(http.StatusServiceUnavailable, UpstreamUnavailable)
+	}
+	e.Upstream = providers.ProblemFrom(err)
+	return e
+}
+
+// classify buckets a raw temperature into Hot/Cold/Moderate using the
+// thresholds for whichever unit it's reported in (81F/45F, 27C/7C).
+func classify(temp float64, unit string) string {
+	if unit == "F" {
+		switch {
+		case temp >= 81:
+			return TempHot // Hot if temperature is 81F or above
+		case temp <= 45:
+			return TempCold // Cold if temperature is 45F or below
+		default:
+			return TempModerate // Moderate if temperature is between 45F and 81F
+		}
+	}
+	switch {
+	case temp >= 27:
+		return TempHot // Hot if temperature is 27C or above
+	case temp <= 7:
+		return TempCold // Cold if temperature is 7C or below
+	default:
+		return TempModerate // Moderate if temperature is between 7C and 27C
+	}
+}
@@ -0,0 +1,194 @@
+package weatherservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/Edmond-Underwood/WeatherService/pb"
+	"github.com/Edmond-Underwood/WeatherService/providers"
+)
+
+// fakeProvider returns a canned Forecast/error pair, so the dispatch and
+// error-mapping logic in this package can be tested without a real
+// upstream.
+type fakeProvider struct {
+	forecast providers.Forecast
+	err      error
+}
+
+func (p *fakeProvider) Fetch(ctx context.Context, lat, lon float64, units string) (providers.Forecast, error) {
+	return p.forecast, p.err
+}
+
+func (p *fakeProvider) FetchPeriods(ctx context.Context, lat, lon float64, units string, days int) ([]providers.Period, error) {
+	return nil, p.err
+}
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name string
+		temp float64
+		unit string
+		want string
+	}{
+		{"hot fahrenheit", 85, "F", TempHot},
+		{"boundary hot fahrenheit", 81, "F", TempHot},
+		{"cold fahrenheit", 30, "F", TempCold},
+		{"boundary cold fahrenheit", 45, "F", TempCold},
+		{"moderate fahrenheit", 60, "F", TempModerate},
+		{"hot celsius", 30, "C", TempHot},
+		{"boundary hot celsius", 27, "C", TempHot},
+		{"cold celsius", 0, "C", TempCold},
+		{"boundary cold celsius", 7, "C", TempCold},
+		{"moderate celsius", 15, "C", TempModerate},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := classify(tt.temp, tt.unit); got != tt.want {
+				t.Errorf("classify(%v, %q) = %q, want %q", tt.temp, tt.unit, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestFetchUnavailableMapsToGRPCUnavailable guards the full path a
+// providers.ErrUnavailable takes end to end: mapProviderError must not
+// collapse it to the generic 500 Internal case, or toGRPCStatus's
+// Unavailable branch becomes dead code.
+func TestFetchUnavailableMapsToGRPCUnavailable(t *testing.T) {
+	svc := NewService(map[string]providers.Provider{"nws": &fakeProvider{err: providers.ErrUnavailable}}, "nws")
+	_, err := svc.Fetch(context.Background(), "nws", 1, 1, "imperial")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if got := status.Code(toGRPCStatus(err)); got != codes.Unavailable {
+		t.Fatalf("toGRPCStatus(%v) code = %v, want %v", err, got, codes.Unavailable)
+	}
+}
+
+func TestToGRPCStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{"bad request maps to InvalidArgument", newError(http.StatusBadRequest, "bad"), codes.InvalidArgument},
+		{"not found maps to NotFound", newError(http.StatusNotFound, "missing"), codes.NotFound},
+		{"bad gateway maps to Unavailable", newError(http.StatusBadGateway, "down"), codes.Unavailable},
+		{"service unavailable maps to Unavailable", newError(http.StatusServiceUnavailable, "down"), codes.Unavailable},
+		{"gateway timeout maps to Unavailable", newError(http.StatusGatewayTimeout, "slow"), codes.Unavailable},
+		{"internal error maps to Internal", newError(http.StatusInternalServerError, "boom"), codes.Internal},
+		{"non-Error falls back to Internal", context.DeadlineExceeded, codes.Internal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := status.Code(toGRPCStatus(tt.err))
+			if got != tt.want {
+				t.Errorf("toGRPCStatus(%v) code = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetWeatherHTTPErrorPaths(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		providers  map[string]providers.Provider
+		wantStatus int
+	}{
+		{
+			name:       "missing lat/lon is a 400",
+			query:      "",
+			providers:  map[string]providers.Provider{},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "provider ErrNotFound is a 404",
+			query:      "lat=1&lon=1",
+			providers:  map[string]providers.Provider{"nws": &fakeProvider{err: providers.ErrNotFound}},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "provider ErrUnavailable is a 503",
+			query:      "lat=1&lon=1",
+			providers:  map[string]providers.Provider{"nws": &fakeProvider{err: providers.ErrUnavailable}},
+			wantStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:       "unrecognized provider error is a 500",
+			query:      "lat=1&lon=1",
+			providers:  map[string]providers.Provider{"nws": &fakeProvider{err: errors.New("boom")}},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewService(tt.providers, "nws")
+			req := httptest.NewRequest(http.MethodGet, "/GetWeather?"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			svc.GetWeather(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			var body ErrorResponse
+			if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+				t.Fatalf("decoding error body: %v", err)
+			}
+			if body.RequestID == "" {
+				t.Error("expected a non-empty requestId")
+			}
+		})
+	}
+}
+
+// TestGRPCServerGetWeatherRoundTrip drives GetWeather over a real gRPC
+// connection (in-memory via bufconn), guarding against the pb package
+// regressing back to hand-written structs that grpc-go can't marshal.
+func TestGRPCServerGetWeatherRoundTrip(t *testing.T) {
+	svc := NewService(map[string]providers.Provider{
+		"nws": &fakeProvider{forecast: providers.Forecast{TempValue: 85, TempUnit: "F", Text: "Sunny"}},
+	}, "nws")
+
+	lis := bufconn.Listen(1024 * 1024)
+	defer lis.Close()
+
+	srv := grpc.NewServer()
+	pb.RegisterWeatherServiceServer(srv, NewGRPCServer(svc, nil))
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock(),
+	)
+	if err != nil {
+		t.Fatalf("dialing bufconn: %v", err)
+	}
+	defer conn.Close()
+
+	client := pb.NewWeatherServiceClient(conn)
+	resp, err := client.GetWeather(context.Background(), &pb.GetWeatherRequest{
+		Location: &pb.OneOfLocation{Location: &pb.OneOfLocation_LatLon{LatLon: &pb.LatLon{Lat: 39.7, Lon: -97.1}}},
+	})
+	if err != nil {
+		t.Fatalf("GetWeather RPC failed: %v", err)
+	}
+	if resp.GetTemperature() != 85 || resp.GetBucket() != TempHot || resp.GetForecast() != "Sunny" {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+}